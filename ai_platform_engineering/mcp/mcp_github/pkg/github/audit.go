@@ -0,0 +1,108 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+)
+
+// AuditEvent records a single destructive org-tool invocation for the audit
+// trail: who did what, to whom, and what GitHub said about it.
+type AuditEvent struct {
+	Action       string    `json:"action"`
+	Actor        string    `json:"actor"`
+	Org          string    `json:"org"`
+	Email        string    `json:"email,omitempty"`
+	Role         string    `json:"role,omitempty"`
+	InvitationID int64     `json:"invitation_id,omitempty"`
+	StatusCode   int       `json:"status_code"`
+	Success      bool      `json:"success"`
+	Error        string    `json:"error,omitempty"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+// AuditSink receives a record of each invitation or membership change that a
+// destructive org tool attempts on GitHub, successful or failed. A call that
+// stops before any such attempt (org allow-list, owner check, invite policy,
+// dry run) is not recorded.
+// Implementations must be safe for concurrent use.
+type AuditSink interface {
+	RecordInvite(ctx context.Context, event AuditEvent) error
+	RecordCancel(ctx context.Context, event AuditEvent) error
+	RecordMembershipChange(ctx context.Context, event AuditEvent) error
+}
+
+// NullSink discards every event. It's the default when no audit sink is
+// configured, so auditing never becomes a hard dependency for a tool call.
+type NullSink struct{}
+
+func (NullSink) RecordInvite(context.Context, AuditEvent) error           { return nil }
+func (NullSink) RecordCancel(context.Context, AuditEvent) error           { return nil }
+func (NullSink) RecordMembershipChange(context.Context, AuditEvent) error { return nil }
+
+// JSONLFileSink appends one JSON object per line to a file, the simplest
+// durable sink that doesn't require any external infrastructure.
+type JSONLFileSink struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewJSONLFileSink creates a sink that appends audit events to path,
+// creating the file (and its parent directory) if necessary.
+func NewJSONLFileSink(path string) (*JSONLFileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create audit log directory for %s: %w", path, err)
+	}
+	return &JSONLFileSink{path: path}, nil
+}
+
+func (s *JSONLFileSink) RecordInvite(_ context.Context, event AuditEvent) error {
+	event.Action = "invite"
+	return s.write(event)
+}
+
+func (s *JSONLFileSink) RecordCancel(_ context.Context, event AuditEvent) error {
+	event.Action = "cancel"
+	return s.write(event)
+}
+
+func (s *JSONLFileSink) RecordMembershipChange(_ context.Context, event AuditEvent) error {
+	event.Action = "membership_change"
+	return s.write(event)
+}
+
+func (s *JSONLFileSink) write(event AuditEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit event: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log %s: %w", s.path, err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit log %s: %w", s.path, err)
+	}
+	return nil
+}
+
+// auditActor resolves the login of the authenticated user for AuditEvent.Actor.
+func auditActor(ctx context.Context, client *github.Client) string {
+	user, _, err := client.Users.Get(ctx, "")
+	if err != nil || user == nil {
+		return ""
+	}
+	return user.GetLogin()
+}
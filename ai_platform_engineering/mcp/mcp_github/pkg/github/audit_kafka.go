@@ -0,0 +1,110 @@
+//go:build audit_kafka
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaSink publishes audit events to a Kafka topic, for deployments that
+// want org-invite/membership events fed into the same event bus as other
+// SSO/auth audit trails.
+type KafkaSink struct {
+	writer *kafka.Writer
+}
+
+// NewKafkaSink creates a sink that produces to topic on the given brokers.
+func NewKafkaSink(brokers []string, topic string) *KafkaSink {
+	return &KafkaSink{
+		writer: &kafka.Writer{
+			Addr:     kafka.TCP(brokers...),
+			Topic:    topic,
+			Balancer: &kafka.LeastBytes{},
+		},
+	}
+}
+
+func (s *KafkaSink) RecordInvite(ctx context.Context, event AuditEvent) error {
+	event.Action = "invite"
+	return s.publish(ctx, event)
+}
+
+func (s *KafkaSink) RecordCancel(ctx context.Context, event AuditEvent) error {
+	event.Action = "cancel"
+	return s.publish(ctx, event)
+}
+
+func (s *KafkaSink) RecordMembershipChange(ctx context.Context, event AuditEvent) error {
+	event.Action = "membership_change"
+	return s.publish(ctx, event)
+}
+
+func (s *KafkaSink) publish(ctx context.Context, event AuditEvent) error {
+	value, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit event: %w", err)
+	}
+	return s.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(event.Org),
+		Value: value,
+		Time:  time.Now(),
+	})
+}
+
+// WebhookSink POSTs audit events as JSON to a configured URL, for deployments
+// that want a simpler fan-out than standing up a Kafka topic.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink creates a sink that POSTs audit events to url.
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{url: url, client: http.DefaultClient}
+}
+
+func (s *WebhookSink) RecordInvite(ctx context.Context, event AuditEvent) error {
+	event.Action = "invite"
+	return s.post(ctx, event)
+}
+
+func (s *WebhookSink) RecordCancel(ctx context.Context, event AuditEvent) error {
+	event.Action = "cancel"
+	return s.post(ctx, event)
+}
+
+func (s *WebhookSink) RecordMembershipChange(ctx context.Context, event AuditEvent) error {
+	event.Action = "membership_change"
+	return s.post(ctx, event)
+}
+
+func (s *WebhookSink) post(ctx context.Context, event AuditEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build audit webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to deliver audit webhook: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("audit webhook returned status %d", res.StatusCode)
+	}
+	return nil
+}
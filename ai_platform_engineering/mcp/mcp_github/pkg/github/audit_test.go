@@ -0,0 +1,82 @@
+package github
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAuditSink records every event handed to it in memory, for tests that
+// need to assert *what* a tool handler reported rather than just that it
+// didn't blow up.
+type fakeAuditSink struct {
+	mu      sync.Mutex
+	invites []AuditEvent
+	cancels []AuditEvent
+	changes []AuditEvent
+}
+
+func (s *fakeAuditSink) RecordInvite(_ context.Context, event AuditEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.invites = append(s.invites, event)
+	return nil
+}
+
+func (s *fakeAuditSink) RecordCancel(_ context.Context, event AuditEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cancels = append(s.cancels, event)
+	return nil
+}
+
+func (s *fakeAuditSink) RecordMembershipChange(_ context.Context, event AuditEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.changes = append(s.changes, event)
+	return nil
+}
+
+func Test_NullSink_DiscardsEvents(t *testing.T) {
+	t.Parallel()
+
+	var sink AuditSink = NullSink{}
+	assert.NoError(t, sink.RecordInvite(context.Background(), AuditEvent{}))
+	assert.NoError(t, sink.RecordCancel(context.Background(), AuditEvent{}))
+	assert.NoError(t, sink.RecordMembershipChange(context.Background(), AuditEvent{}))
+}
+
+func Test_JSONLFileSink_AppendsEvents(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "nested", "audit.jsonl")
+	sink, err := NewJSONLFileSink(path)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.RecordInvite(context.Background(), AuditEvent{Org: "cisco-eti", Email: "alice@cisco.com"}))
+	require.NoError(t, sink.RecordCancel(context.Background(), AuditEvent{Org: "cisco-eti", InvitationID: 5}))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var events []AuditEvent
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var event AuditEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	require.Len(t, events, 2)
+	assert.Equal(t, "invite", events[0].Action)
+	assert.Equal(t, "alice@cisco.com", events[0].Email)
+	assert.Equal(t, "cancel", events[1].Action)
+	assert.Equal(t, int64(5), events[1].InvitationID)
+}
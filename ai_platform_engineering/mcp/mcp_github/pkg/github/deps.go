@@ -0,0 +1,92 @@
+package github
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/google/go-github/v82/github"
+)
+
+// BaseDeps is the default ToolDependencies implementation threaded through
+// every tool handler.
+type BaseDeps struct {
+	Client *github.Client
+
+	// OrgAllowList restricts which orgs org-mutating tools may target.
+	// Empty means no restriction is configured.
+	OrgAllowList []string
+
+	// PolicyPath is the path to the invite-policy YAML file consulted before
+	// issuing org invitations. Empty disables policy enforcement.
+	PolicyPath string
+
+	// AuditSink receives a record of each invitation or membership change
+	// that a destructive org tool attempts on GitHub. Nil falls back to NullSink.
+	AuditSink AuditSink
+}
+
+// ToolDependencies is the interface tool handlers are given; BaseDeps is its
+// default, env/config-driven implementation.
+type ToolDependencies interface {
+	GetClient(ctx context.Context) (*github.Client, error)
+	GetOrgAllowList() []string
+	GetPolicyPath() string
+	GetAuditSink() AuditSink
+}
+
+func (d BaseDeps) GetClient(context.Context) (*github.Client, error) {
+	return d.Client, nil
+}
+
+func (d BaseDeps) GetOrgAllowList() []string {
+	return d.OrgAllowList
+}
+
+func (d BaseDeps) GetPolicyPath() string {
+	return d.PolicyPath
+}
+
+func (d BaseDeps) GetAuditSink() AuditSink {
+	if d.AuditSink == nil {
+		return NullSink{}
+	}
+	return d.AuditSink
+}
+
+// Environment variables NewBaseDepsFromEnv reads to populate the
+// org-mutating-tool guardrails.
+const (
+	envOrgAllowList    = "GITHUB_MCP_ORG_ALLOWLIST"
+	envInvitePolicyURL = "GITHUB_MCP_INVITE_POLICY_PATH"
+	envAuditLogPath    = "GITHUB_MCP_AUDIT_LOG_PATH"
+)
+
+// NewBaseDepsFromEnv builds a BaseDeps around client, loading the
+// org-mutating-tool guardrails from environment configuration:
+//   - GITHUB_MCP_ORG_ALLOWLIST: comma-separated list of orgs tools may target.
+//   - GITHUB_MCP_INVITE_POLICY_PATH: path to the invite-policy YAML file.
+//   - GITHUB_MCP_AUDIT_LOG_PATH: path to a JSONL audit log; unset disables auditing.
+func NewBaseDepsFromEnv(client *github.Client) (BaseDeps, error) {
+	deps := BaseDeps{Client: client}
+
+	if raw := os.Getenv(envOrgAllowList); raw != "" {
+		for _, org := range strings.Split(raw, ",") {
+			if org = strings.TrimSpace(org); org != "" {
+				deps.OrgAllowList = append(deps.OrgAllowList, org)
+			}
+		}
+	}
+
+	deps.PolicyPath = os.Getenv(envInvitePolicyURL)
+
+	if path := os.Getenv(envAuditLogPath); path != "" {
+		sink, err := NewJSONLFileSink(path)
+		if err != nil {
+			return BaseDeps{}, err
+		}
+		deps.AuditSink = sink
+	}
+
+	return deps, nil
+}
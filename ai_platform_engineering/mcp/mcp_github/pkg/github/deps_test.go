@@ -0,0 +1,32 @@
+package github
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewBaseDepsFromEnv(t *testing.T) {
+	auditPath := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	t.Setenv(envOrgAllowList, "cisco-eti, another-org ,")
+	t.Setenv(envInvitePolicyURL, "/etc/github-mcp/invite-policy.yaml")
+	t.Setenv(envAuditLogPath, auditPath)
+
+	deps, err := NewBaseDepsFromEnv(nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"cisco-eti", "another-org"}, deps.GetOrgAllowList())
+	assert.Equal(t, "/etc/github-mcp/invite-policy.yaml", deps.GetPolicyPath())
+	assert.IsType(t, &JSONLFileSink{}, deps.GetAuditSink())
+}
+
+func Test_BaseDeps_DefaultsWithoutEnv(t *testing.T) {
+	deps := BaseDeps{}
+
+	assert.Empty(t, deps.GetOrgAllowList())
+	assert.Empty(t, deps.GetPolicyPath())
+	assert.Equal(t, NullSink{}, deps.GetAuditSink())
+}
@@ -0,0 +1,147 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// freeEmailDomains backs `require_corporate_domain`: well-known consumer
+// webmail providers are never considered corporate, regardless of the
+// allow/deny lists.
+var freeEmailDomains = map[string]bool{
+	"gmail.com":      true,
+	"yahoo.com":      true,
+	"outlook.com":    true,
+	"hotmail.com":    true,
+	"icloud.com":     true,
+	"aol.com":        true,
+	"protonmail.com": true,
+}
+
+// InvitePolicy constrains which email addresses may be invited to an org.
+// A zero-value InvitePolicy imposes no domain restriction; evaluateInvitePolicy
+// still rejects an address that has no domain.
+type InvitePolicy struct {
+	AllowedEmailDomains    []string `yaml:"allowed_email_domains"`
+	DeniedEmailDomains     []string `yaml:"denied_email_domains"`
+	RequireCorporateDomain bool     `yaml:"require_corporate_domain"`
+}
+
+// invitePolicyCache reloads the policy file from disk whenever its mtime
+// changes, so operators can edit `BaseDeps.PolicyPath` without restarting
+// the MCP server.
+type invitePolicyCache struct {
+	mu       sync.RWMutex
+	path     string
+	modTime  int64
+	policies map[string]InvitePolicy
+}
+
+var sharedInvitePolicyCache invitePolicyCache
+
+// policyForOrg returns the InvitePolicy configured for org at path, reloading
+// the file if it has changed since the last call. An org with no entry in
+// the file gets the zero-value (unrestricted) policy.
+func (c *invitePolicyCache) policyForOrg(path, org string) (InvitePolicy, error) {
+	if path == "" {
+		return InvitePolicy{}, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return InvitePolicy{}, fmt.Errorf("failed to stat invite policy file %s: %w", path, err)
+	}
+
+	modTime := info.ModTime().UnixNano()
+
+	c.mu.RLock()
+	if c.path == path && c.modTime == modTime {
+		policy := c.policies[org]
+		c.mu.RUnlock()
+		return policy, nil
+	}
+	c.mu.RUnlock()
+
+	policies, err := loadInvitePolicies(path)
+	if err != nil {
+		return InvitePolicy{}, err
+	}
+	c.mu.Lock()
+	c.path = path
+	c.modTime = modTime
+	c.policies = policies
+	c.mu.Unlock()
+	return policies[org], nil
+}
+
+// loadInvitePolicies parses a YAML file mapping org name to InvitePolicy.
+func loadInvitePolicies(path string) (map[string]InvitePolicy, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read invite policy file %s: %w", path, err)
+	}
+
+	var policies map[string]InvitePolicy
+	if err := yaml.Unmarshal(data, &policies); err != nil {
+		return nil, fmt.Errorf("failed to parse invite policy file %s: %w", path, err)
+	}
+	return policies, nil
+}
+
+// isPolicyConfigured reports whether policy imposes any restriction at all.
+// Callers that only have an unverifiable identifier (e.g. a username whose
+// GitHub profile email is private) should skip enforcement entirely when
+// this is false, rather than rejecting every such call.
+func isPolicyConfigured(policy InvitePolicy) bool {
+	return len(policy.AllowedEmailDomains) > 0 || len(policy.DeniedEmailDomains) > 0 || policy.RequireCorporateDomain
+}
+
+// evaluateInvitePolicy checks email against policy, returning a
+// machine-readable reason ("domain_not_allowed" or "domain_denied") and
+// false when the email is rejected.
+func evaluateInvitePolicy(policy InvitePolicy, email string) (reason string, ok bool) {
+	domain := strings.ToLower(domainOf(email))
+	if domain == "" {
+		return "domain_not_allowed", false
+	}
+
+	if domainMatchesAny(domain, policy.DeniedEmailDomains) {
+		return "domain_denied", false
+	}
+
+	if len(policy.AllowedEmailDomains) > 0 && !domainMatchesAny(domain, policy.AllowedEmailDomains) {
+		return "domain_not_allowed", false
+	}
+
+	if policy.RequireCorporateDomain && freeEmailDomains[domain] {
+		return "domain_not_allowed", false
+	}
+
+	return "", true
+}
+
+// domainOf returns the part of email after the '@', or "" if email is
+// malformed.
+func domainOf(email string) string {
+	_, domain, found := strings.Cut(email, "@")
+	if !found {
+		return ""
+	}
+	return domain
+}
+
+// domainMatchesAny reports whether domain matches any glob in patterns
+// (e.g. "*.cisco.com").
+func domainMatchesAny(domain string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matched, err := filepath.Match(strings.ToLower(pattern), domain); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
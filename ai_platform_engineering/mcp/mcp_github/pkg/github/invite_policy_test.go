@@ -0,0 +1,104 @@
+package github
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EvaluateInvitePolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		policy    InvitePolicy
+		email     string
+		expectOK  bool
+		expectWhy string
+	}{
+		{
+			name:     "no policy allows everything",
+			policy:   InvitePolicy{},
+			email:    "alice@gmail.com",
+			expectOK: true,
+		},
+		{
+			name:      "denied domain is rejected",
+			policy:    InvitePolicy{DeniedEmailDomains: []string{"competitor.com"}},
+			email:     "alice@competitor.com",
+			expectOK:  false,
+			expectWhy: "domain_denied",
+		},
+		{
+			name:      "allow-list rejects domains not listed",
+			policy:    InvitePolicy{AllowedEmailDomains: []string{"*.cisco.com"}},
+			email:     "alice@gmail.com",
+			expectOK:  false,
+			expectWhy: "domain_not_allowed",
+		},
+		{
+			name:     "allow-list glob matches subdomain",
+			policy:   InvitePolicy{AllowedEmailDomains: []string{"*.cisco.com"}},
+			email:    "alice@eti.cisco.com",
+			expectOK: true,
+		},
+		{
+			name:      "require corporate domain rejects free webmail",
+			policy:    InvitePolicy{RequireCorporateDomain: true},
+			email:     "alice@gmail.com",
+			expectOK:  false,
+			expectWhy: "domain_not_allowed",
+		},
+		{
+			name:      "malformed email is rejected",
+			policy:    InvitePolicy{},
+			email:     "not-an-email",
+			expectOK:  false,
+			expectWhy: "domain_not_allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			reason, ok := evaluateInvitePolicy(tc.policy, tc.email)
+			assert.Equal(t, tc.expectOK, ok)
+			if !tc.expectOK {
+				assert.Equal(t, tc.expectWhy, reason)
+			}
+		})
+	}
+}
+
+func Test_IsPolicyConfigured(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, isPolicyConfigured(InvitePolicy{}))
+	assert.True(t, isPolicyConfigured(InvitePolicy{AllowedEmailDomains: []string{"cisco.com"}}))
+	assert.True(t, isPolicyConfigured(InvitePolicy{DeniedEmailDomains: []string{"spam.com"}}))
+	assert.True(t, isPolicyConfigured(InvitePolicy{RequireCorporateDomain: true}))
+}
+
+func Test_InvitePolicyCache_ReloadsOnChange(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "invite_policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("cisco-eti:\n  denied_email_domains: [\"spam.com\"]\n"), 0o600))
+
+	var cache invitePolicyCache
+
+	policy, err := cache.policyForOrg(path, "cisco-eti")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"spam.com"}, policy.DeniedEmailDomains)
+
+	require.NoError(t, os.WriteFile(path, []byte("cisco-eti:\n  require_corporate_domain: true\n"), 0o600))
+
+	policy, err = cache.policyForOrg(path, "cisco-eti")
+	require.NoError(t, err)
+	assert.True(t, policy.RequireCorporateDomain)
+	assert.Empty(t, policy.DeniedEmailDomains)
+}
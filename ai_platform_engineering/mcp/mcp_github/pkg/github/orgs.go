@@ -2,7 +2,14 @@ package github
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/inventory"
@@ -14,6 +21,490 @@ import (
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
+const (
+	// bulkInviteDefaultConcurrency is used when the caller does not specify one.
+	bulkInviteDefaultConcurrency = 4
+	// bulkInviteMaxConcurrency caps the concurrency param so a single call can't
+	// hammer the org invitations endpoint.
+	bulkInviteMaxConcurrency = 10
+	// bulkInviteRateLimitFloor is the X-RateLimit-Remaining threshold at or below
+	// which we pause the batch until the window resets.
+	bulkInviteRateLimitFloor = 2
+)
+
+// bulkInviteRow is a single requested invitation, whether it came from the
+// inline `invitees` array or a decoded CSV/JSON payload.
+type bulkInviteRow struct {
+	Email    string  `json:"email"`
+	Username string  `json:"username,omitempty"`
+	Role     string  `json:"role,omitempty"`
+	TeamIDs  []int64 `json:"team_ids,omitempty"`
+}
+
+// bulkInviteRowResult reports what happened for a single row so a partial
+// failure never aborts the rest of the batch.
+type bulkInviteRowResult struct {
+	Email        string `json:"email"`
+	Status       string `json:"status"` // invited, skipped_already_member, skipped_pending, failed, dry_run
+	InvitationID int64  `json:"invitation_id,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// BulkInviteUsersToOrg creates a tool that reconciles a batch of invitations
+// against an org's current members and pending invitations, then only issues
+// CreateOrgInvitation for the delta. It accepts either an inline `invitees`
+// array or a base64-encoded `payload` (CSV or JSON) of the same shape.
+func BulkInviteUsersToOrg(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataOrgs,
+		mcp.Tool{
+			Name: "bulk_invite_users_to_org",
+			Description: t("TOOL_BULK_INVITE_USERS_TO_ORG_DESCRIPTION",
+				"Invite many users to a GitHub organization in one call. Reconciles the "+
+					"requested rows against pending invitations (by email) and, for rows "+
+					"that include a `username`, against current org members (by login - "+
+					"the members list doesn't expose email addresses) so already-invited "+
+					"or already-joined rows are skipped. A single row failing does not "+
+					"abort the rest of the batch."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_BULK_INVITE_USERS_TO_ORG_TITLE", "Bulk invite users to organization"),
+				DestructiveHint: ToBoolPtr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"org": {
+						Type:        "string",
+						Description: t("TOOL_BULK_INVITE_USERS_TO_ORG_ORG", "GitHub organization name (e.g. cisco-eti)"),
+					},
+					"invitees": {
+						Type:        "array",
+						Description: t("TOOL_BULK_INVITE_USERS_TO_ORG_INVITEES", "Inline list of invitees. Mutually exclusive with `payload`."),
+						Items: &jsonschema.Schema{
+							Type: "object",
+							Properties: map[string]*jsonschema.Schema{
+								"email": {Type: "string"},
+								"username": {
+									Type:        "string",
+									Description: "GitHub login of the invitee, if known. Used to detect existing members, since the org members list does not expose email addresses.",
+								},
+								"role": {Type: "string", Enum: []any{"admin", "direct_member", "billing_manager"}},
+								"team_ids": {
+									Type:  "array",
+									Items: &jsonschema.Schema{Type: "integer"},
+								},
+							},
+							Required: []string{"email"},
+						},
+					},
+					"payload": {
+						Type: "string",
+						Description: t("TOOL_BULK_INVITE_USERS_TO_ORG_PAYLOAD",
+							"Base64-encoded CSV or JSON payload of invitees, as an alternative to `invitees`."),
+					},
+					"payload_format": {
+						Type:        "string",
+						Description: t("TOOL_BULK_INVITE_USERS_TO_ORG_PAYLOAD_FORMAT", "Format of `payload`. Required when `payload` is set."),
+						Enum:        []any{"csv", "json"},
+					},
+					"dry_run": {
+						Type:        "boolean",
+						Description: t("TOOL_BULK_INVITE_USERS_TO_ORG_DRY_RUN", "If true, compute the delta and report it without inviting anyone."),
+					},
+					"concurrency": {
+						Type:        "integer",
+						Description: t("TOOL_BULK_INVITE_USERS_TO_ORG_CONCURRENCY", "Max invitations to issue in parallel. Default 4, capped at 10."),
+					},
+				},
+				Required: []string{"org"},
+			},
+		},
+		[]scopes.Scope{scopes.AdminOrg},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			org, err := RequiredParam[string](args, "org")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			dryRun, err := OptionalParam[bool](args, "dry_run")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			concurrency, err := OptionalParam[float64](args, "concurrency")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			workers := bulkInviteDefaultConcurrency
+			if concurrency > 0 {
+				workers = int(concurrency)
+			}
+			if workers > bulkInviteMaxConcurrency {
+				workers = bulkInviteMaxConcurrency
+			}
+			if workers < 1 {
+				workers = 1
+			}
+
+			rows, err := parseBulkInviteRows(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if len(rows) == 0 {
+				return utils.NewToolResultError("one of `invitees` or `payload` must contain at least one row"), nil, nil
+			}
+
+			if !isOrgAllowed(deps.GetOrgAllowList(), org) {
+				return orgToolError("org_not_allowed", fmt.Sprintf("org %s is not in the configured allow-list", org)), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			if err := requireOrgOwner(ctx, client, org); err != nil {
+				return orgToolError("not_org_owner", err.Error()), nil, nil
+			}
+
+			policy, err := sharedInvitePolicyCache.policyForOrg(deps.GetPolicyPath(), org)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to load invite policy", err), nil, nil
+			}
+
+			pendingEmails, memberLogins, err := fetchExistingOrgInviteState(ctx, client, org)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to reconcile existing invitations/members for org %s", org),
+					nil,
+					err,
+				), nil, nil
+			}
+
+			results := make([]bulkInviteRowResult, len(rows))
+			sem := make(chan struct{}, workers)
+			var wg sync.WaitGroup
+			var rateState rateLimitState
+			sink := deps.GetAuditSink()
+			actor := auditActor(ctx, client)
+
+			for i, row := range rows {
+				email := strings.ToLower(strings.TrimSpace(row.Email))
+				if email == "" {
+					results[i] = bulkInviteRowResult{Status: "failed", Error: "row has no email"}
+					continue
+				}
+				if row.Username != "" && memberLogins[strings.ToLower(row.Username)] {
+					results[i] = bulkInviteRowResult{Email: email, Status: "skipped_already_member"}
+					continue
+				}
+				if pendingEmails[email] {
+					results[i] = bulkInviteRowResult{Email: email, Status: "skipped_pending"}
+					continue
+				}
+				if reason, ok := evaluateInvitePolicy(policy, email); !ok {
+					results[i] = bulkInviteRowResult{Email: email, Status: "failed", Error: reason}
+					continue
+				}
+				if dryRun {
+					results[i] = bulkInviteRowResult{Email: email, Status: "dry_run"}
+					continue
+				}
+
+				wg.Add(1)
+				sem <- struct{}{}
+				go func(i int, row bulkInviteRow, email string) {
+					defer wg.Done()
+					defer func() { <-sem }()
+
+					rateState.waitIfNeeded(ctx)
+
+					role := row.Role
+					if role == "" {
+						role = "direct_member"
+					}
+					opts := &github.CreateOrgInvitationOptions{
+						Email: &email,
+						Role:  &role,
+					}
+					if len(row.TeamIDs) > 0 {
+						opts.TeamID = row.TeamIDs
+					}
+
+					invitation, res, err := client.Organizations.CreateOrgInvitation(ctx, org, opts)
+					rateState.update(res)
+
+					event := AuditEvent{Actor: actor, Org: org, Email: email, Role: role, Timestamp: time.Now()}
+					if res != nil && res.Response != nil {
+						event.StatusCode = res.StatusCode
+					}
+					if err != nil {
+						event.Error = err.Error()
+					} else {
+						event.Success = true
+						event.InvitationID = invitation.GetID()
+					}
+					recordAuditBestEffort(ctx, sink.RecordInvite, event)
+
+					if err != nil {
+						results[i] = bulkInviteRowResult{Email: email, Status: "failed", Error: err.Error()}
+						return
+					}
+					results[i] = bulkInviteRowResult{
+						Email:        email,
+						Status:       "invited",
+						InvitationID: invitation.GetID(),
+					}
+				}(i, row, email)
+			}
+			wg.Wait()
+
+			summary := map[string]int{}
+			for _, r := range results {
+				summary[r.Status]++
+			}
+
+			return MarshalledTextResult(map[string]any{
+				"org":     org,
+				"dry_run": dryRun,
+				"summary": summary,
+				"results": results,
+			}), nil, nil
+		},
+	)
+}
+
+// parseBulkInviteRows extracts the requested rows from either the inline
+// `invitees` array or a base64-encoded `payload` in CSV or JSON form.
+func parseBulkInviteRows(args map[string]any) ([]bulkInviteRow, error) {
+	if raw, ok := args["invitees"]; ok && raw != nil {
+		encoded, err := json.Marshal(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid invitees: %w", err)
+		}
+		var rows []bulkInviteRow
+		if err := json.Unmarshal(encoded, &rows); err != nil {
+			return nil, fmt.Errorf("invalid invitees: %w", err)
+		}
+		return rows, nil
+	}
+
+	payload, err := OptionalParam[string](args, "payload")
+	if err != nil {
+		return nil, err
+	}
+	if payload == "" {
+		return nil, nil
+	}
+
+	format, err := OptionalParam[string](args, "payload_format")
+	if err != nil {
+		return nil, err
+	}
+	if format == "" {
+		return nil, fmt.Errorf("payload_format is required when payload is set")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, fmt.Errorf("payload is not valid base64: %w", err)
+	}
+
+	switch format {
+	case "json":
+		var rows []bulkInviteRow
+		if err := json.Unmarshal(decoded, &rows); err != nil {
+			return nil, fmt.Errorf("invalid JSON payload: %w", err)
+		}
+		return rows, nil
+	case "csv":
+		return parseBulkInviteCSV(decoded)
+	default:
+		return nil, fmt.Errorf("unsupported payload_format: %s", format)
+	}
+}
+
+// parseBulkInviteCSV expects a header row of email,role,team_ids where
+// team_ids is a `;`-separated list of numeric team IDs.
+func parseBulkInviteCSV(data []byte) ([]bulkInviteRow, error) {
+	reader := csv.NewReader(strings.NewReader(string(data)))
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV payload: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	col := make(map[string]int, len(header))
+	for i, name := range header {
+		col[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	emailIdx, ok := col["email"]
+	if !ok {
+		return nil, fmt.Errorf("CSV payload is missing an `email` column")
+	}
+
+	rows := make([]bulkInviteRow, 0, len(records)-1)
+	for _, record := range records[1:] {
+		row := bulkInviteRow{Email: record[emailIdx]}
+		if idx, ok := col["role"]; ok && idx < len(record) {
+			row.Role = record[idx]
+		}
+		if idx, ok := col["team_ids"]; ok && idx < len(record) && record[idx] != "" {
+			for _, id := range strings.Split(record[idx], ";") {
+				teamID, err := strconv.ParseInt(strings.TrimSpace(id), 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid team id %q: %w", id, err)
+				}
+				row.TeamIDs = append(row.TeamIDs, teamID)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// fetchExistingOrgInviteState paginates through an org's pending invitations
+// and members to build the sets used to compute the invitation delta.
+// Pending invitations are keyed by email (the only identifier the invitations
+// API exposes); members are keyed by login, since GET /orgs/{org}/members
+// never returns an email address.
+func fetchExistingOrgInviteState(ctx context.Context, client *github.Client, org string) (pendingEmails map[string]bool, memberLogins map[string]bool, err error) {
+	pendingEmails = map[string]bool{}
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		invitations, res, err := client.Organizations.ListPendingOrgInvitations(ctx, org, &github.ListOptions{Page: opts.Page, PerPage: opts.PerPage})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, invitation := range invitations {
+			if email := strings.ToLower(invitation.GetEmail()); email != "" {
+				pendingEmails[email] = true
+			}
+		}
+		if res.NextPage == 0 {
+			break
+		}
+		opts.Page = res.NextPage
+	}
+
+	memberLogins = map[string]bool{}
+	memberOpts := &github.ListMembersOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		users, res, err := client.Organizations.ListMembers(ctx, org, memberOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, user := range users {
+			if login := strings.ToLower(user.GetLogin()); login != "" {
+				memberLogins[login] = true
+			}
+		}
+		if res.NextPage == 0 {
+			break
+		}
+		memberOpts.Page = res.NextPage
+	}
+
+	return pendingEmails, memberLogins, nil
+}
+
+// rateLimitState tracks the most recently observed org-invitations rate
+// limit window so concurrent bulk-invite workers can back off *before*
+// issuing a request that would trip the secondary rate limit. The lock only
+// ever guards the two fields below - it is never held across a sleep, so one
+// goroutine waiting for a reset cannot block any other goroutine.
+type rateLimitState struct {
+	mu        sync.Mutex
+	remaining int
+	resetAt   time.Time
+}
+
+// update records the rate limit window observed on res, if any.
+func (s *rateLimitState) update(res *github.Response) {
+	if res == nil || res.Response == nil {
+		return
+	}
+	remaining, err := strconv.Atoi(res.Response.Header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	resetUnix, err := strconv.ParseInt(res.Response.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+	s.mu.Lock()
+	s.remaining = remaining
+	s.resetAt = time.Unix(resetUnix, 0)
+	s.mu.Unlock()
+}
+
+// waitIfNeeded pauses the calling goroutine, and only the calling goroutine,
+// until the rate limit window resets if the last observed response was at or
+// below bulkInviteRateLimitFloor remaining requests.
+func (s *rateLimitState) waitIfNeeded(ctx context.Context) {
+	s.mu.Lock()
+	remaining, resetAt := s.remaining, s.resetAt
+	s.mu.Unlock()
+
+	if remaining > bulkInviteRateLimitFloor {
+		return
+	}
+	wait := time.Until(resetAt)
+	if wait <= 0 {
+		return
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(wait):
+	}
+}
+
+// recordAuditBestEffort records event via record, deliberately discarding any
+// sink error: a failure to log an event must never mask the result of a
+// mutation that has already happened, nor risk a caller retrying (and
+// double-inviting/double-cancelling) just because the audit write failed.
+func recordAuditBestEffort(ctx context.Context, record func(context.Context, AuditEvent) error, event AuditEvent) {
+	_ = record(ctx, event)
+}
+
+// orgToolError builds a structured tool error whose message is prefixed with
+// a machine-readable reason (e.g. "not_org_owner"), so callers can branch on
+// the reason instead of pattern-matching GitHub's prose.
+func orgToolError(reason, detail string) *mcp.CallToolResult {
+	return utils.NewToolResultError(fmt.Sprintf("%s: %s", reason, detail))
+}
+
+// requireOrgOwner verifies that the authenticated user is an owner of org,
+// returning a descriptive error instead of letting a bare 403 from
+// CreateOrgInvitation reach the caller.
+func requireOrgOwner(ctx context.Context, client *github.Client, org string) error {
+	membership, _, err := client.Organizations.GetOrgMembership(ctx, "", org)
+	if err != nil {
+		return fmt.Errorf("authenticated user is not a member of org %s", org)
+	}
+	if membership.GetRole() != "admin" {
+		return fmt.Errorf("authenticated user is not an owner of org %s", org)
+	}
+	return nil
+}
+
+// isOrgAllowed reports whether org may be targeted by org-mutating tools.
+// An empty allow-list means no restriction is configured.
+func isOrgAllowed(allowList []string, org string) bool {
+	if len(allowList) == 0 {
+		return true
+	}
+	for _, allowed := range allowList {
+		if strings.EqualFold(allowed, org) {
+			return true
+		}
+	}
+	return false
+}
+
 // InviteUserToOrg creates a tool to invite a user to a GitHub organization
 // via email address, matching the POST /orgs/{org}/invitations API.
 func InviteUserToOrg(t translations.TranslationHelperFunc) inventory.ServerTool {
@@ -70,17 +561,53 @@ func InviteUserToOrg(t translations.TranslationHelperFunc) inventory.ServerTool
 				role = "direct_member"
 			}
 
+			if !isOrgAllowed(deps.GetOrgAllowList(), org) {
+				return orgToolError("org_not_allowed", fmt.Sprintf("org %s is not in the configured allow-list", org)), nil, nil
+			}
+
 			client, err := deps.GetClient(ctx)
 			if err != nil {
 				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
 			}
 
+			if err := requireOrgOwner(ctx, client, org); err != nil {
+				return orgToolError("not_org_owner", err.Error()), nil, nil
+			}
+
+			policy, err := sharedInvitePolicyCache.policyForOrg(deps.GetPolicyPath(), org)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to load invite policy", err), nil, nil
+			}
+			if reason, ok := evaluateInvitePolicy(policy, email); !ok {
+				return orgToolError(reason, fmt.Sprintf("%s is not permitted to invite into org %s", email, org)), nil, nil
+			}
+
 			opts := &github.CreateOrgInvitationOptions{
 				Email: &email,
 				Role:  &role,
 			}
 
 			invitation, res, err := client.Organizations.CreateOrgInvitation(ctx, org, opts)
+
+			event := AuditEvent{
+				Actor:     auditActor(ctx, client),
+				Org:       org,
+				Email:     email,
+				Role:      role,
+				Timestamp: time.Now(),
+			}
+			if res != nil && res.Response != nil {
+				event.StatusCode = res.StatusCode
+			}
+			if err != nil {
+				event.Success = false
+				event.Error = err.Error()
+			} else {
+				event.Success = true
+				event.InvitationID = invitation.GetID()
+			}
+			recordAuditBestEffort(ctx, deps.GetAuditSink().RecordInvite, event)
+
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					fmt.Sprintf("failed to invite %s to org %s", email, org),
@@ -103,3 +630,455 @@ func InviteUserToOrg(t translations.TranslationHelperFunc) inventory.ServerTool
 		},
 	)
 }
+
+// ListPendingOrgInvitations creates a tool to list an org's pending
+// invitations, matching the GET /orgs/{org}/invitations API.
+func ListPendingOrgInvitations(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataOrgs,
+		mcp.Tool{
+			Name: "list_pending_org_invitations",
+			Description: t("TOOL_LIST_PENDING_ORG_INVITATIONS_DESCRIPTION",
+				"List pending invitations for a GitHub organization, optionally filtered by role or invitation source."),
+			Annotations: &mcp.ToolAnnotations{
+				Title: t("TOOL_LIST_PENDING_ORG_INVITATIONS_TITLE", "List pending organization invitations"),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"org": {
+						Type:        "string",
+						Description: t("TOOL_LIST_PENDING_ORG_INVITATIONS_ORG", "GitHub organization name (e.g. cisco-eti)"),
+					},
+					"role": {
+						Type:        "string",
+						Description: t("TOOL_LIST_PENDING_ORG_INVITATIONS_ROLE", "Only return invitations with this role."),
+					},
+					"invitation_source": {
+						Type:        "string",
+						Description: t("TOOL_LIST_PENDING_ORG_INVITATIONS_SOURCE", "Only return invitations from this source (e.g. 'member', 'scim')."),
+					},
+					"page": {
+						Type:        "integer",
+						Description: t("TOOL_LIST_PENDING_ORG_INVITATIONS_PAGE", "Page number of the results to fetch."),
+					},
+					"per_page": {
+						Type:        "integer",
+						Description: t("TOOL_LIST_PENDING_ORG_INVITATIONS_PER_PAGE", "Results per page (max 100)."),
+					},
+				},
+				Required: []string{"org"},
+			},
+		},
+		[]scopes.Scope{scopes.AdminOrg},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			org, err := RequiredParam[string](args, "org")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			role, err := OptionalParam[string](args, "role")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			invitationSource, err := OptionalParam[string](args, "invitation_source")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			listOpts, err := listOptionsFromArgs(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			invitations, res, err := client.Organizations.ListPendingOrgInvitations(ctx, org, listOpts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list pending invitations for org %s", org),
+					res,
+					err,
+				), nil, nil
+			}
+
+			filtered := make([]*github.Invitation, 0, len(invitations))
+			for _, invitation := range invitations {
+				if role != "" && invitation.GetRole() != role {
+					continue
+				}
+				if invitationSource != "" && invitation.GetInvitationSource() != invitationSource {
+					continue
+				}
+				filtered = append(filtered, invitation)
+			}
+
+			return MarshalledTextResult(filtered), nil, nil
+		},
+	)
+}
+
+// CancelOrgInvitation creates a tool to revoke a pending organization
+// invitation, matching the DELETE /orgs/{org}/invitations/{invitation_id} API.
+func CancelOrgInvitation(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataOrgs,
+		mcp.Tool{
+			Name: "cancel_org_invitation",
+			Description: t("TOOL_CANCEL_ORG_INVITATION_DESCRIPTION",
+				"Cancel a pending invitation to a GitHub organization."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_CANCEL_ORG_INVITATION_TITLE", "Cancel organization invitation"),
+				DestructiveHint: ToBoolPtr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"org": {
+						Type:        "string",
+						Description: t("TOOL_CANCEL_ORG_INVITATION_ORG", "GitHub organization name (e.g. cisco-eti)"),
+					},
+					"invitation_id": {
+						Type:        "integer",
+						Description: t("TOOL_CANCEL_ORG_INVITATION_ID", "ID of the invitation to cancel."),
+					},
+				},
+				Required: []string{"org", "invitation_id"},
+			},
+		},
+		[]scopes.Scope{scopes.AdminOrg},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			org, err := RequiredParam[string](args, "org")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			invitationID, err := RequiredParam[float64](args, "invitation_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			if !isOrgAllowed(deps.GetOrgAllowList(), org) {
+				return orgToolError("org_not_allowed", fmt.Sprintf("org %s is not in the configured allow-list", org)), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			if err := requireOrgOwner(ctx, client, org); err != nil {
+				return orgToolError("not_org_owner", err.Error()), nil, nil
+			}
+
+			res, err := client.Organizations.CancelInvite(ctx, org, int64(invitationID))
+
+			event := AuditEvent{
+				Actor:        auditActor(ctx, client),
+				Org:          org,
+				InvitationID: int64(invitationID),
+				Success:      err == nil,
+				Timestamp:    time.Now(),
+			}
+			if res != nil && res.Response != nil {
+				event.StatusCode = res.StatusCode
+			}
+			if err != nil {
+				event.Error = err.Error()
+			}
+			recordAuditBestEffort(ctx, deps.GetAuditSink().RecordCancel, event)
+
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to cancel invitation %d for org %s", int64(invitationID), org),
+					res,
+					err,
+				), nil, nil
+			}
+
+			return MarshalledTextResult(map[string]any{
+				"org":           org,
+				"invitation_id": int64(invitationID),
+				"cancelled":     true,
+			}), nil, nil
+		},
+	)
+}
+
+// ListOrgInvitationTeams creates a tool to list the teams a pending org
+// invitation will be added to, matching the
+// GET /orgs/{org}/invitations/{invitation_id}/teams API.
+func ListOrgInvitationTeams(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataOrgs,
+		mcp.Tool{
+			Name: "list_org_invitation_teams",
+			Description: t("TOOL_LIST_ORG_INVITATION_TEAMS_DESCRIPTION",
+				"List the teams that a pending organization invitation will add the invitee to."),
+			Annotations: &mcp.ToolAnnotations{
+				Title: t("TOOL_LIST_ORG_INVITATION_TEAMS_TITLE", "List organization invitation teams"),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"org": {
+						Type:        "string",
+						Description: t("TOOL_LIST_ORG_INVITATION_TEAMS_ORG", "GitHub organization name (e.g. cisco-eti)"),
+					},
+					"invitation_id": {
+						Type:        "integer",
+						Description: t("TOOL_LIST_ORG_INVITATION_TEAMS_ID", "ID of the invitation to inspect."),
+					},
+					"page": {
+						Type:        "integer",
+						Description: t("TOOL_LIST_ORG_INVITATION_TEAMS_PAGE", "Page number of the results to fetch."),
+					},
+					"per_page": {
+						Type:        "integer",
+						Description: t("TOOL_LIST_ORG_INVITATION_TEAMS_PER_PAGE", "Results per page (max 100)."),
+					},
+				},
+				Required: []string{"org", "invitation_id"},
+			},
+		},
+		[]scopes.Scope{scopes.AdminOrg},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			org, err := RequiredParam[string](args, "org")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			invitationID, err := RequiredParam[float64](args, "invitation_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			listOpts, err := listOptionsFromArgs(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			teams, res, err := client.Organizations.ListOrgInvitationTeams(ctx, org, strconv.FormatInt(int64(invitationID), 10), listOpts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list teams for invitation %d in org %s", int64(invitationID), org),
+					res,
+					err,
+				), nil, nil
+			}
+
+			return MarshalledTextResult(teams), nil, nil
+		},
+	)
+}
+
+// listOptionsFromArgs builds a github.ListOptions from the optional `page`
+// and `per_page` tool arguments.
+func listOptionsFromArgs(args map[string]any) (*github.ListOptions, error) {
+	page, err := OptionalParam[float64](args, "page")
+	if err != nil {
+		return nil, err
+	}
+	perPage, err := OptionalParam[float64](args, "per_page")
+	if err != nil {
+		return nil, err
+	}
+	return &github.ListOptions{Page: int(page), PerPage: int(perPage)}, nil
+}
+
+// AddUserToOrgTeam creates a tool that adds an existing GitHub user directly
+// to an org team via Teams.AddTeamMembershipBySlug, skipping the email
+// invitation round-trip. If the user isn't already a member of the org,
+// GitHub returns a 404 and this falls back to CreateOrgInvitation with the
+// team pre-populated, so the invitee lands on the team as soon as they accept.
+func AddUserToOrgTeam(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataOrgs,
+		mcp.Tool{
+			Name: "add_user_to_org_team",
+			Description: t("TOOL_ADD_USER_TO_ORG_TEAM_DESCRIPTION",
+				"Add an existing GitHub user to an organization team by username. "+
+					"Falls back to an email invitation with the team pre-assigned if the "+
+					"user is not yet a member of the org."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_ADD_USER_TO_ORG_TEAM_TITLE", "Add user to organization team"),
+				DestructiveHint: ToBoolPtr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"org": {
+						Type:        "string",
+						Description: t("TOOL_ADD_USER_TO_ORG_TEAM_ORG", "GitHub organization name (e.g. cisco-eti)"),
+					},
+					"team_slug": {
+						Type:        "string",
+						Description: t("TOOL_ADD_USER_TO_ORG_TEAM_TEAM_SLUG", "Slug of the team to add the user to."),
+					},
+					"username": {
+						Type:        "string",
+						Description: t("TOOL_ADD_USER_TO_ORG_TEAM_USERNAME", "GitHub username of an existing user."),
+					},
+					"role": {
+						Type:        "string",
+						Description: t("TOOL_ADD_USER_TO_ORG_TEAM_ROLE", "Team role for the user. Default is 'member'."),
+						Enum:        []any{"member", "maintainer"},
+					},
+				},
+				Required: []string{"org", "team_slug", "username"},
+			},
+		},
+		[]scopes.Scope{scopes.AdminOrg},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			org, err := RequiredParam[string](args, "org")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			teamSlug, err := RequiredParam[string](args, "team_slug")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			username, err := RequiredParam[string](args, "username")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			role, err := OptionalParam[string](args, "role")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if role == "" {
+				role = "member"
+			}
+
+			if !isOrgAllowed(deps.GetOrgAllowList(), org) {
+				return orgToolError("org_not_allowed", fmt.Sprintf("org %s is not in the configured allow-list", org)), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			if err := requireOrgOwner(ctx, client, org); err != nil {
+				return orgToolError("not_org_owner", err.Error()), nil, nil
+			}
+
+			membership, res, err := client.Teams.AddTeamMembershipBySlug(ctx, org, teamSlug, username, &github.TeamAddTeamMembershipOptions{
+				Role: role,
+			})
+			if err == nil {
+				recordAuditBestEffort(ctx, deps.GetAuditSink().RecordMembershipChange, AuditEvent{
+					Actor:      auditActor(ctx, client),
+					Org:        org,
+					Role:       membership.GetRole(),
+					Success:    true,
+					StatusCode: res.StatusCode,
+					Timestamp:  time.Now(),
+				})
+				return MarshalledTextResult(map[string]any{
+					"org":       org,
+					"team_slug": teamSlug,
+					"username":  username,
+					"role":      membership.GetRole(),
+					"state":     membership.GetState(),
+					"fallback":  false,
+				}), nil, nil
+			}
+			failedEvent := AuditEvent{
+				Actor:     auditActor(ctx, client),
+				Org:       org,
+				Role:      role,
+				Error:     err.Error(),
+				Timestamp: time.Now(),
+			}
+			if res != nil && res.Response != nil {
+				failedEvent.StatusCode = res.StatusCode
+			}
+			recordAuditBestEffort(ctx, deps.GetAuditSink().RecordMembershipChange, failedEvent)
+			if res == nil || res.StatusCode != 404 {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to add %s to team %s/%s", username, org, teamSlug),
+					res,
+					err,
+				), nil, nil
+			}
+
+			// The user isn't an org member yet: fall back to an email invitation
+			// with the team pre-populated so they land on it once they accept.
+			team, res, err := client.Teams.GetTeamBySlug(ctx, org, teamSlug)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to look up team %s/%s", org, teamSlug),
+					res,
+					err,
+				), nil, nil
+			}
+
+			user, res, err := client.Users.Get(ctx, username)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to look up GitHub user %s", username),
+					res,
+					err,
+				), nil, nil
+			}
+
+			policy, err := sharedInvitePolicyCache.policyForOrg(deps.GetPolicyPath(), org)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to load invite policy", err), nil, nil
+			}
+			// user.GetEmail() is "" unless the invitee has a public profile
+			// email. With no policy configured that's fine; with one
+			// configured we can't verify it, so we reject rather than
+			// silently bypassing it.
+			if isPolicyConfigured(policy) {
+				if reason, ok := evaluateInvitePolicy(policy, user.GetEmail()); !ok {
+					return orgToolError(reason, fmt.Sprintf("%s is not permitted to invite into org %s", username, org)), nil, nil
+				}
+			}
+
+			inviteRole := "direct_member"
+			invitation, res, err := client.Organizations.CreateOrgInvitation(ctx, org, &github.CreateOrgInvitationOptions{
+				InviteeID: user.ID,
+				Role:      &inviteRole,
+				TeamID:    []int64{team.GetID()},
+			})
+
+			membershipEvent := AuditEvent{
+				Actor:     auditActor(ctx, client),
+				Org:       org,
+				Role:      inviteRole,
+				Success:   err == nil,
+				Timestamp: time.Now(),
+			}
+			if res != nil && res.Response != nil {
+				membershipEvent.StatusCode = res.StatusCode
+			}
+			if err != nil {
+				membershipEvent.Error = err.Error()
+			} else {
+				membershipEvent.InvitationID = invitation.GetID()
+			}
+			recordAuditBestEffort(ctx, deps.GetAuditSink().RecordMembershipChange, membershipEvent)
+
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to invite %s to org %s for team %s", username, org, teamSlug),
+					res,
+					err,
+				), nil, nil
+			}
+
+			return MarshalledTextResult(map[string]any{
+				"org":           org,
+				"team_slug":     teamSlug,
+				"username":      username,
+				"fallback":      true,
+				"invitation_id": invitation.GetID(),
+			}), nil, nil
+		},
+	)
+}
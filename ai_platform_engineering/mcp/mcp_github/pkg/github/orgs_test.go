@@ -3,15 +3,22 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v82/github"
 	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func Test_InviteUserToOrg(t *testing.T) {
@@ -37,6 +44,9 @@ func Test_InviteUserToOrg(t *testing.T) {
 		Role:  github.Ptr("direct_member"),
 	}
 
+	ownerMembership := mockResponse(t, http.StatusOK, &github.Membership{Role: github.Ptr("admin")})
+	memberMembership := mockResponse(t, http.StatusOK, &github.Membership{Role: github.Ptr("member")})
+
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
@@ -47,7 +57,8 @@ func Test_InviteUserToOrg(t *testing.T) {
 		{
 			name: "successful invitation by email",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-				PostOrgsInvitationsByOrg: mockResponse(t, http.StatusCreated, mockInvitation),
+				GetUserMembershipsOrgsByOrg: ownerMembership,
+				PostOrgsInvitationsByOrg:    mockResponse(t, http.StatusCreated, mockInvitation),
 			}),
 			requestArgs: map[string]any{
 				"org":   "cisco-eti",
@@ -58,7 +69,8 @@ func Test_InviteUserToOrg(t *testing.T) {
 		{
 			name: "successful invitation with role",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-				PostOrgsInvitationsByOrg: mockResponse(t, http.StatusCreated, mockInvitation),
+				GetUserMembershipsOrgsByOrg: ownerMembership,
+				PostOrgsInvitationsByOrg:    mockResponse(t, http.StatusCreated, mockInvitation),
 			}),
 			requestArgs: map[string]any{
 				"org":   "cisco-eti",
@@ -70,7 +82,8 @@ func Test_InviteUserToOrg(t *testing.T) {
 		{
 			name: "missing org parameter",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-				PostOrgsInvitationsByOrg: mockResponse(t, http.StatusCreated, mockInvitation),
+				GetUserMembershipsOrgsByOrg: ownerMembership,
+				PostOrgsInvitationsByOrg:    mockResponse(t, http.StatusCreated, mockInvitation),
 			}),
 			requestArgs:    map[string]any{"email": "alice@cisco.com"},
 			expectError:    true,
@@ -79,7 +92,8 @@ func Test_InviteUserToOrg(t *testing.T) {
 		{
 			name: "missing email parameter",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-				PostOrgsInvitationsByOrg: mockResponse(t, http.StatusCreated, mockInvitation),
+				GetUserMembershipsOrgsByOrg: ownerMembership,
+				PostOrgsInvitationsByOrg:    mockResponse(t, http.StatusCreated, mockInvitation),
 			}),
 			requestArgs:    map[string]any{"org": "cisco-eti"},
 			expectError:    true,
@@ -88,6 +102,7 @@ func Test_InviteUserToOrg(t *testing.T) {
 		{
 			name: "API error - 422 already invited",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUserMembershipsOrgsByOrg: ownerMembership,
 				PostOrgsInvitationsByOrg: mockResponse(t, http.StatusUnprocessableEntity, map[string]string{
 					"message": "Validation Failed",
 				}),
@@ -95,6 +110,16 @@ func Test_InviteUserToOrg(t *testing.T) {
 			requestArgs: map[string]any{"org": "cisco-eti", "email": "alice@cisco.com"},
 			expectError: true,
 		},
+		{
+			name: "authenticated user is not an org owner",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUserMembershipsOrgsByOrg: memberMembership,
+				PostOrgsInvitationsByOrg:    mockResponse(t, http.StatusCreated, mockInvitation),
+			}),
+			requestArgs:    map[string]any{"org": "cisco-eti", "email": "alice@cisco.com"},
+			expectError:    true,
+			expectedErrMsg: "not_org_owner",
+		},
 	}
 
 	for _, tc := range tests {
@@ -133,3 +158,455 @@ func Test_InviteUserToOrg(t *testing.T) {
 		})
 	}
 }
+
+func Test_BulkInviteUsersToOrg(t *testing.T) {
+	t.Parallel()
+
+	serverTool := BulkInviteUsersToOrg(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "bulk_invite_users_to_org", tool.Name)
+	assert.True(t, *tool.Annotations.DestructiveHint, "bulk_invite_users_to_org should be destructive")
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetUserMembershipsOrgsByOrg: mockResponse(t, http.StatusOK, &github.Membership{Role: github.Ptr("admin")}),
+		GetOrgsInvitationsByOrg: mockResponse(t, http.StatusOK, []*github.Invitation{
+			{Email: github.Ptr("already-pending@cisco.com")},
+		}),
+		GetOrgsMembersByOrg: mockResponse(t, http.StatusOK, []*github.User{
+			{Login: github.Ptr("bob")},
+		}),
+		PostOrgsInvitationsByOrg: mockResponse(t, http.StatusCreated, &github.Invitation{
+			ID:    github.Ptr(int64(99)),
+			Email: github.Ptr("new-hire@cisco.com"),
+			Role:  github.Ptr("direct_member"),
+		}),
+	})
+
+	client := github.NewClient(mockedClient)
+	deps := BaseDeps{Client: client}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"org": "cisco-eti",
+		"invitees": []map[string]any{
+			{"email": "new-hire@cisco.com"},
+			{"email": "already-pending@cisco.com"},
+			{"email": "already-member@cisco.com", "username": "bob"},
+		},
+	})
+
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, "unexpected tool error")
+
+	textContent := getTextResult(t, result)
+	var response map[string]any
+	err = json.Unmarshal([]byte(textContent.Text), &response)
+	require.NoError(t, err)
+
+	summary, ok := response["summary"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, float64(1), summary["invited"])
+	assert.Equal(t, float64(1), summary["skipped_pending"])
+	assert.Equal(t, float64(1), summary["skipped_already_member"])
+}
+
+func Test_BulkInviteUsersToOrg_RespectsRateLimitBeforeNextRequest(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetUserMembershipsOrgsByOrg: mockResponse(t, http.StatusOK, &github.Membership{Role: github.Ptr("admin")}),
+		GetOrgsInvitationsByOrg:     mockResponse(t, http.StatusOK, []*github.Invitation{}),
+		GetOrgsMembersByOrg:         mockResponse(t, http.StatusOK, []*github.User{}),
+		PostOrgsInvitationsByOrg: func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			// X-RateLimit-Reset is in whole Unix seconds, so set it far enough
+			// out that it is still at least 100ms away once truncated.
+			w.Header().Set("X-RateLimit-Reset", fmt.Sprintf("%d", time.Now().Add(1100*time.Millisecond).Unix()))
+			mockResponse(t, http.StatusCreated, &github.Invitation{ID: github.Ptr(int64(1))})(w, r)
+		},
+	})
+
+	client := github.NewClient(mockedClient)
+	deps := BaseDeps{Client: client}
+	handler := BulkInviteUsersToOrg(translations.NullTranslationHelper).Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"org":         "cisco-eti",
+		"concurrency": float64(1),
+		"invitees": []map[string]any{
+			{"email": "one@cisco.com"},
+			{"email": "two@cisco.com"},
+		},
+	})
+
+	start := time.Now()
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	elapsed := time.Since(start)
+
+	require.NoError(t, err)
+	require.False(t, result.IsError, "unexpected tool error")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	// The first call reports remaining=0, so the second must wait out the
+	// reset window before firing - proving the check runs before the request,
+	// not after.
+	assert.GreaterOrEqual(t, elapsed, 40*time.Millisecond)
+}
+
+func Test_BulkInviteUsersToOrg_FractionalConcurrencyDoesNotHang(t *testing.T) {
+	t.Parallel()
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetUserMembershipsOrgsByOrg: mockResponse(t, http.StatusOK, &github.Membership{Role: github.Ptr("admin")}),
+		GetOrgsInvitationsByOrg:     mockResponse(t, http.StatusOK, []*github.Invitation{}),
+		GetOrgsMembersByOrg:         mockResponse(t, http.StatusOK, []*github.User{}),
+		PostOrgsInvitationsByOrg: mockResponse(t, http.StatusCreated, &github.Invitation{
+			ID: github.Ptr(int64(1)),
+		}),
+	})
+
+	client := github.NewClient(mockedClient)
+	deps := BaseDeps{Client: client}
+	handler := BulkInviteUsersToOrg(translations.NullTranslationHelper).Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"org":         "cisco-eti",
+		"concurrency": float64(0.5),
+		"invitees": []map[string]any{
+			{"email": "one@cisco.com"},
+		},
+	})
+
+	done := make(chan struct{})
+	var result *mcp.CallToolResult
+	var err error
+	go func() {
+		result, err = handler(ContextWithDeps(context.Background(), deps), &request)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("handler hung with a sub-1 concurrency value; workers must be clamped to at least 1")
+	}
+
+	require.NoError(t, err)
+	require.False(t, result.IsError, "unexpected tool error")
+}
+
+func Test_ListPendingOrgInvitations(t *testing.T) {
+	t.Parallel()
+
+	serverTool := ListPendingOrgInvitations(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.Equal(t, "list_pending_org_invitations", tool.Name)
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetOrgsInvitationsByOrg: mockResponse(t, http.StatusOK, []*github.Invitation{
+			{ID: github.Ptr(int64(1)), Email: github.Ptr("admin-invite@cisco.com"), Role: github.Ptr("admin")},
+			{ID: github.Ptr(int64(2)), Email: github.Ptr("member-invite@cisco.com"), Role: github.Ptr("direct_member")},
+		}),
+	})
+
+	client := github.NewClient(mockedClient)
+	deps := BaseDeps{Client: client}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{"org": "cisco-eti", "role": "admin"})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, "unexpected tool error")
+
+	var invitations []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &invitations))
+	require.Len(t, invitations, 1)
+	assert.Equal(t, "admin-invite@cisco.com", invitations[0]["email"])
+}
+
+func Test_CancelOrgInvitation(t *testing.T) {
+	t.Parallel()
+
+	serverTool := CancelOrgInvitation(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.Equal(t, "cancel_org_invitation", tool.Name)
+	assert.True(t, *tool.Annotations.DestructiveHint, "cancel_org_invitation should be destructive")
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetUserMembershipsOrgsByOrg:              mockResponse(t, http.StatusOK, &github.Membership{Role: github.Ptr("admin")}),
+		DeleteOrgsInvitationsByOrgByInvitationId: mockResponse(t, http.StatusNoContent, nil),
+	})
+
+	client := github.NewClient(mockedClient)
+	deps := BaseDeps{Client: client}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{"org": "cisco-eti", "invitation_id": float64(12345)})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, "unexpected tool error")
+}
+
+func Test_CancelOrgInvitation_GuardsOrgAllowListAndOwnership(t *testing.T) {
+	t.Parallel()
+
+	serverTool := CancelOrgInvitation(translations.NullTranslationHelper)
+
+	t.Run("org not in allow-list is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		client := github.NewClient(MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}))
+		deps := BaseDeps{Client: client, OrgAllowList: []string{"other-org"}}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{"org": "cisco-eti", "invitation_id": float64(12345)})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError, "expected tool error")
+		assert.Contains(t, getErrorResult(t, result).Text, "org_not_allowed")
+	})
+
+	t.Run("non-owner is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetUserMembershipsOrgsByOrg: mockResponse(t, http.StatusOK, &github.Membership{Role: github.Ptr("member")}),
+		})
+		client := github.NewClient(mockedClient)
+		deps := BaseDeps{Client: client}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{"org": "cisco-eti", "invitation_id": float64(12345)})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError, "expected tool error")
+		assert.Contains(t, getErrorResult(t, result).Text, "not_org_owner")
+	})
+}
+
+func Test_ListOrgInvitationTeams(t *testing.T) {
+	t.Parallel()
+
+	serverTool := ListOrgInvitationTeams(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.Equal(t, "list_org_invitation_teams", tool.Name)
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetOrgsInvitationsByOrgByInvitationIdTeams: mockResponse(t, http.StatusOK, []*github.Team{
+			{ID: github.Ptr(int64(1)), Slug: github.Ptr("platform-eng")},
+		}),
+	})
+
+	client := github.NewClient(mockedClient)
+	deps := BaseDeps{Client: client}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{"org": "cisco-eti", "invitation_id": float64(12345)})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, "unexpected tool error")
+
+	var teams []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &teams))
+	require.Len(t, teams, 1)
+	assert.Equal(t, "platform-eng", teams[0]["slug"])
+}
+
+func Test_AddUserToOrgTeam(t *testing.T) {
+	t.Parallel()
+
+	serverTool := AddUserToOrgTeam(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.Equal(t, "add_user_to_org_team", tool.Name)
+
+	ownerMembership := mockResponse(t, http.StatusOK, &github.Membership{Role: github.Ptr("admin")})
+
+	t.Run("existing member is added directly", func(t *testing.T) {
+		t.Parallel()
+
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetUserMembershipsOrgsByOrg: ownerMembership,
+			PutOrgsTeamsByOrgByTeamSlugMembershipsByUsername: mockResponse(t, http.StatusOK, &github.Membership{
+				Role:  github.Ptr("member"),
+				State: github.Ptr("active"),
+			}),
+		})
+
+		client := github.NewClient(mockedClient)
+		deps := BaseDeps{Client: client}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{"org": "cisco-eti", "team_slug": "platform-eng", "username": "alice"})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, "unexpected tool error")
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, false, response["fallback"])
+	})
+
+	t.Run("non-member falls back to invitation", func(t *testing.T) {
+		t.Parallel()
+
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetUserMembershipsOrgsByOrg: ownerMembership,
+			PutOrgsTeamsByOrgByTeamSlugMembershipsByUsername: mockResponse(t, http.StatusNotFound, map[string]string{
+				"message": "Not Found",
+			}),
+			GetOrgsTeamsByOrgByTeamSlug: mockResponse(t, http.StatusOK, &github.Team{ID: github.Ptr(int64(7))}),
+			GetUsersByUsername:          mockResponse(t, http.StatusOK, &github.User{ID: github.Ptr(int64(42))}),
+			PostOrgsInvitationsByOrg: mockResponse(t, http.StatusCreated, &github.Invitation{
+				ID: github.Ptr(int64(321)),
+			}),
+		})
+
+		client := github.NewClient(mockedClient)
+		deps := BaseDeps{Client: client}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{"org": "cisco-eti", "team_slug": "platform-eng", "username": "new-hire"})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, "unexpected tool error")
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, true, response["fallback"])
+		assert.Equal(t, float64(321), response["invitation_id"])
+	})
+
+	t.Run("fallback invite is rejected by a configured policy", func(t *testing.T) {
+		t.Parallel()
+
+		policyPath := writeInvitePolicyFile(t, "cisco-eti", InvitePolicy{
+			AllowedEmailDomains: []string{"cisco.com"},
+		})
+
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetUserMembershipsOrgsByOrg: ownerMembership,
+			PutOrgsTeamsByOrgByTeamSlugMembershipsByUsername: mockResponse(t, http.StatusNotFound, map[string]string{
+				"message": "Not Found",
+			}),
+			GetOrgsTeamsByOrgByTeamSlug: mockResponse(t, http.StatusOK, &github.Team{ID: github.Ptr(int64(7))}),
+			GetUsersByUsername:          mockResponse(t, http.StatusOK, &github.User{ID: github.Ptr(int64(42))}),
+		})
+
+		client := github.NewClient(mockedClient)
+		deps := BaseDeps{Client: client, PolicyPath: policyPath}
+		handler := serverTool.Handler(deps)
+
+		request := createMCPRequest(map[string]any{"org": "cisco-eti", "team_slug": "platform-eng", "username": "no-public-email"})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError, "expected tool error")
+		assert.Contains(t, getErrorResult(t, result).Text, "domain_not_allowed")
+	})
+}
+
+// writeInvitePolicyFile writes policy for org to a temp YAML file and
+// returns its path, for tests that exercise policy enforcement through a
+// real tool handler rather than evaluateInvitePolicy directly.
+func writeInvitePolicyFile(t *testing.T, org string, policy InvitePolicy) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "invite-policy.yaml")
+	data, err := yaml.Marshal(map[string]InvitePolicy{org: policy})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+	return path
+}
+
+func Test_InviteUserToOrg_AuditAndPolicyEndToEnd(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successful invite is recorded on the audit sink", func(t *testing.T) {
+		t.Parallel()
+
+		sink := &fakeAuditSink{}
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetUserMembershipsOrgsByOrg: mockResponse(t, http.StatusOK, &github.Membership{Role: github.Ptr("admin")}),
+			PostOrgsInvitationsByOrg: mockResponse(t, http.StatusCreated, &github.Invitation{
+				ID:    github.Ptr(int64(777)),
+				Email: github.Ptr("alice@cisco.com"),
+				Role:  github.Ptr("direct_member"),
+			}),
+		})
+
+		client := github.NewClient(mockedClient)
+		deps := BaseDeps{Client: client, AuditSink: sink}
+		handler := InviteUserToOrg(translations.NullTranslationHelper).Handler(deps)
+
+		request := createMCPRequest(map[string]any{"org": "cisco-eti", "email": "alice@cisco.com"})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, "unexpected tool error")
+
+		require.Len(t, sink.invites, 1)
+		assert.Equal(t, "cisco-eti", sink.invites[0].Org)
+		assert.Equal(t, "alice@cisco.com", sink.invites[0].Email)
+		assert.True(t, sink.invites[0].Success)
+		assert.Equal(t, int64(777), sink.invites[0].InvitationID)
+	})
+
+	t.Run("invite rejected by a configured policy is never recorded as success", func(t *testing.T) {
+		t.Parallel()
+
+		policyPath := writeInvitePolicyFile(t, "cisco-eti", InvitePolicy{
+			AllowedEmailDomains: []string{"cisco.com"},
+		})
+
+		sink := &fakeAuditSink{}
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetUserMembershipsOrgsByOrg: mockResponse(t, http.StatusOK, &github.Membership{Role: github.Ptr("admin")}),
+			PostOrgsInvitationsByOrg: mockResponse(t, http.StatusCreated, &github.Invitation{
+				ID: github.Ptr(int64(778)),
+			}),
+		})
+
+		client := github.NewClient(mockedClient)
+		deps := BaseDeps{Client: client, AuditSink: sink, PolicyPath: policyPath}
+		handler := InviteUserToOrg(translations.NullTranslationHelper).Handler(deps)
+
+		request := createMCPRequest(map[string]any{"org": "cisco-eti", "email": "eve@gmail.com"})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError, "expected tool error")
+		assert.Contains(t, getErrorResult(t, result).Text, "domain_not_allowed")
+
+		// The policy check happens before CreateOrgInvitation is ever called,
+		// so no audit event should be recorded for a rejected invite.
+		assert.Empty(t, sink.invites)
+	})
+}
+
+func Test_CancelOrgInvitation_AuditEndToEnd(t *testing.T) {
+	t.Parallel()
+
+	sink := &fakeAuditSink{}
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetUserMembershipsOrgsByOrg:              mockResponse(t, http.StatusOK, &github.Membership{Role: github.Ptr("admin")}),
+		DeleteOrgsInvitationsByOrgByInvitationId: mockResponse(t, http.StatusNoContent, nil),
+	})
+
+	client := github.NewClient(mockedClient)
+	deps := BaseDeps{Client: client, AuditSink: sink}
+	handler := CancelOrgInvitation(translations.NullTranslationHelper).Handler(deps)
+
+	request := createMCPRequest(map[string]any{"org": "cisco-eti", "invitation_id": float64(12345)})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, "unexpected tool error")
+
+	require.Len(t, sink.cancels, 1)
+	assert.Equal(t, "cisco-eti", sink.cancels[0].Org)
+	assert.Equal(t, int64(12345), sink.cancels[0].InvitationID)
+	assert.True(t, sink.cancels[0].Success)
+}